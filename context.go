@@ -0,0 +1,113 @@
+// File: logger/context.go
+// Context-aware logging: stash and retrieve a *zap.SugaredLogger on a
+// context.Context, automatically enriched with correlation IDs.
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type loggerCtxKey struct{}
+
+type (
+	traceIDCtxKey   struct{}
+	spanIDCtxKey    struct{}
+	requestIDCtxKey struct{}
+)
+
+// TraceExtractor pulls a trace/span ID pair out of a context.Context. The
+// default implementation reads the values stashed by WithTraceID/WithSpanID,
+// which keeps this package free of a hard OpenTelemetry dependency. Services
+// that use OTel can swap it in during init, e.g.:
+//
+//	logger.TraceExtractor = func(ctx context.Context) (traceID, spanID string) {
+//		sc := trace.SpanContextFromContext(ctx)
+//		if !sc.IsValid() {
+//			return "", ""
+//		}
+//		return sc.TraceID().String(), sc.SpanID().String()
+//	}
+var TraceExtractor = func(ctx context.Context) (traceID, spanID string) {
+	if v, ok := ctx.Value(traceIDCtxKey{}).(string); ok {
+		traceID = v
+	}
+	if v, ok := ctx.Value(spanIDCtxKey{}).(string); ok {
+		spanID = v
+	}
+	return traceID, spanID
+}
+
+// WithTraceID stores a trace ID on ctx for the default TraceExtractor to pick up.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDCtxKey{}, traceID)
+}
+
+// WithSpanID stores a span ID on ctx for the default TraceExtractor to pick up.
+func WithSpanID(ctx context.Context, spanID string) context.Context {
+	return context.WithValue(ctx, spanIDCtxKey{}, spanID)
+}
+
+// WithRequestID stores a request ID on ctx; FromContext/Ctx attach it to
+// every log line as "request_id".
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stashed by WithRequestID, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(requestIDCtxKey{}).(string)
+	return v, ok
+}
+
+// WithContext stashes l on ctx so FromContext/Ctx can retrieve it downstream,
+// typically right after building a per-request logger in a middleware.
+func WithContext(ctx context.Context, l *zap.SugaredLogger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// FromContext returns the logger stashed in ctx by WithContext, enriched with
+// trace_id/span_id/request_id when present. If ctx carries no logger, it
+// falls back to the global Log (see New).
+func FromContext(ctx context.Context) *zap.SugaredLogger {
+	l, ok := ctx.Value(loggerCtxKey{}).(*zap.SugaredLogger)
+	if !ok {
+		ensureDefaultInit()
+		l = Log
+	}
+	return withCorrelationFields(ctx, l)
+}
+
+// Ctx is New plus FromContext in one call: it returns a "module" logger,
+// reusing the base logger stashed in ctx when present, with trace_id,
+// span_id and request_id attached automatically.
+func Ctx(ctx context.Context, module string) *zap.SugaredLogger {
+	base, ok := ctx.Value(loggerCtxKey{}).(*zap.SugaredLogger)
+	if !ok {
+		ensureDefaultInit()
+		base = Log
+	}
+	return withCorrelationFields(ctx, base.With("module", module))
+}
+
+// withCorrelationFields attaches conventional correlation fields pulled out
+// of ctx, skipping any that are empty so plain contexts stay unaffected.
+func withCorrelationFields(ctx context.Context, l *zap.SugaredLogger) *zap.SugaredLogger {
+	fields := make([]interface{}, 0, 6)
+	if traceID, spanID := TraceExtractor(ctx); traceID != "" || spanID != "" {
+		if traceID != "" {
+			fields = append(fields, "trace_id", traceID)
+		}
+		if spanID != "" {
+			fields = append(fields, "span_id", spanID)
+		}
+	}
+	if requestID, ok := RequestIDFromContext(ctx); ok && requestID != "" {
+		fields = append(fields, "request_id", requestID)
+	}
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func observedSugar() (*zap.SugaredLogger, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	return zap.New(core).Sugar(), logs
+}
+
+func fieldValue(entry observer.LoggedEntry, key string) (interface{}, bool) {
+	for _, f := range entry.Context {
+		if f.Key != key {
+			continue
+		}
+		if f.Type == zapcore.StringType {
+			return f.String, true
+		}
+		return f.Interface, true
+	}
+	return nil, false
+}
+
+func TestFromContextAttachesCorrelationFields(t *testing.T) {
+	base, logs := observedSugar()
+
+	ctx := context.Background()
+	ctx = WithTraceID(ctx, "trace-123")
+	ctx = WithSpanID(ctx, "span-456")
+	ctx = WithRequestID(ctx, "req-789")
+	ctx = WithContext(ctx, base)
+
+	FromContext(ctx).Info("hello")
+
+	if logs.Len() != 1 {
+		t.Fatalf("expected 1 log entry, got %d", logs.Len())
+	}
+	entry := logs.All()[0]
+
+	for key, want := range map[string]string{
+		"trace_id":   "trace-123",
+		"span_id":    "span-456",
+		"request_id": "req-789",
+	} {
+		got, ok := fieldValue(entry, key)
+		if !ok {
+			t.Fatalf("expected field %q to be present, entry: %+v", key, entry.Context)
+		}
+		if got != want {
+			t.Fatalf("field %q = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestFromContextPlainContextAddsNoCorrelationFields(t *testing.T) {
+	base, logs := observedSugar()
+
+	ctx := WithContext(context.Background(), base)
+	FromContext(ctx).Info("hello")
+
+	entry := logs.All()[0]
+	for _, key := range []string{"trace_id", "span_id", "request_id"} {
+		if _, ok := fieldValue(entry, key); ok {
+			t.Fatalf("did not expect field %q on a plain context", key)
+		}
+	}
+}
+
+func TestCtxAttachesModuleAndRequestID(t *testing.T) {
+	base, logs := observedSugar()
+
+	ctx := WithRequestID(context.Background(), "req-abc")
+	ctx = WithContext(ctx, base)
+
+	Ctx(ctx, "billing").Info("charged")
+
+	entry := logs.All()[0]
+	if got, ok := fieldValue(entry, "module"); !ok || got != "billing" {
+		t.Fatalf("expected module=billing, got %v (present=%v)", got, ok)
+	}
+	if got, ok := fieldValue(entry, "request_id"); !ok || got != "req-abc" {
+		t.Fatalf("expected request_id=req-abc, got %v (present=%v)", got, ok)
+	}
+}
@@ -0,0 +1,74 @@
+// File: logger/format.go
+// Human-readable console encoder for local development, alongside the
+// existing JSON encoder used everywhere else.
+package logger
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/term"
+)
+
+const (
+	formatJSON    = "json"
+	formatConsole = "console"
+)
+
+// WithFormat overrides the encoder choice Init would otherwise make: "json"
+// or "console". With no option, InitWithOptions falls back to LOG_FORMAT,
+// then to console-with-color when env=="dev" and stdout is a TTY, json
+// otherwise.
+func WithFormat(format string) Option {
+	return func(cfg *buildConfig) {
+		cfg.format = strings.ToLower(strings.TrimSpace(format))
+	}
+}
+
+// resolveFormat applies the same explicit-arg > env var > default precedence
+// Init already uses for level.
+func resolveFormat(explicit, env string) string {
+	f := explicit
+	if f == "" {
+		f = strings.ToLower(strings.TrimSpace(os.Getenv("LOG_FORMAT")))
+	}
+	if f == formatJSON || f == formatConsole {
+		return f
+	}
+	if env == "dev" && term.IsTerminal(int(os.Stdout.Fd())) {
+		return formatConsole
+	}
+	return formatJSON
+}
+
+// buildEncoder returns the zapcore.Encoder matching format.
+func buildEncoder(format string) zapcore.Encoder {
+	if format == formatConsole {
+		return zapcore.NewConsoleEncoder(consoleEncoderConfig())
+	}
+	return jsonEncoder()
+}
+
+// consoleEncoderConfig mirrors jsonEncoder's keys but adds color levels and
+// a bracketed time/caller, in the spirit of XProxy's dev-mode encoder.
+func consoleEncoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		LevelKey:       "level",
+		NameKey:        "logger",
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.CapitalColorLevelEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+		EncodeTime: func(t time.Time, pa zapcore.PrimitiveArrayEncoder) {
+			pa.AppendString("[" + t.Format("15:04:05.000") + "]")
+		},
+		EncodeCaller: func(c zapcore.EntryCaller, pa zapcore.PrimitiveArrayEncoder) {
+			pa.AppendString("[" + c.TrimmedPath() + "]")
+		},
+	}
+}
@@ -0,0 +1,70 @@
+// File: logger/httplog/httplog.go
+// Package httplog provides net/http request-logging middleware built on top
+// of logger.New("http"), mirroring ginlog for services not on gin.
+package httplog
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	logger "github.com/NlightN22/go-logger"
+)
+
+// Middleware wraps next, timing each request and logging method, path,
+// status, latency_ms, client_ip and user_agent. It also stashes the "http"
+// logger and a generated request_id on the request context, so downstream
+// handlers calling logger.FromContext(r.Context()) get a logger tagged with
+// that request_id.
+func Middleware(next http.Handler) http.Handler {
+	base := logger.New("http")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		requestID := uuid.NewString()
+
+		// Stash request_id on ctx (not baked into the stored logger) so
+		// downstream FromContext/Ctx calls attach it exactly once via
+		// withCorrelationFields.
+		ctx := logger.WithContext(logger.WithRequestID(r.Context(), requestID), base)
+		r = r.WithContext(ctx)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		base.Infow("http request",
+			"request_id", requestID,
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"client_ip", clientIP(r),
+			"user_agent", r.UserAgent(),
+		)
+	})
+}
+
+// statusRecorder captures the status code written through an
+// http.ResponseWriter, which otherwise doesn't expose it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// clientIP returns RemoteAddr with the port stripped, handling bracketed
+// IPv6 hosts correctly; it falls back to the raw value if it isn't a
+// "host:port" pair.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,54 @@
+// File: logger/level.go
+// Dynamic log level: the AtomicLevel backing Init can be read or changed at
+// runtime, via code, HTTP, or SIGHUP, without restarting the process.
+package logger
+
+import (
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"go.uber.org/zap"
+)
+
+// Level returns the AtomicLevel backing the global logger so callers can
+// inspect or change the current threshold, e.g. logger.Level().SetLevel(zap.DebugLevel).
+func Level() zap.AtomicLevel {
+	return level
+}
+
+// LevelHandler returns an http.Handler mirroring zap's AtomicLevel.ServeHTTP:
+// GET returns the current level as JSON, PUT with {"level":"debug"} sets it.
+// Wire it up once, e.g. mux.Handle("/debug/log/level", logger.LevelHandler()).
+func LevelHandler() http.Handler {
+	return level
+}
+
+// InstallSignalReload re-reads LOG_LEVEL and applies it to the global level
+// whenever one of sig is received (SIGHUP by default). It returns immediately
+// and keeps listening for the lifetime of the process.
+func InstallSignalReload(sig ...os.Signal) {
+	if len(sig) == 0 {
+		sig = []os.Signal{syscall.SIGHUP}
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig...)
+
+	go func() {
+		for range ch {
+			lvlStr := strings.TrimSpace(os.Getenv("LOG_LEVEL"))
+			if lvlStr == "" {
+				continue
+			}
+			ensureDefaultInit() // guard against a SIGHUP arriving before Init
+			if err := level.UnmarshalText([]byte(strings.ToLower(lvlStr))); err != nil {
+				Log.Warnw("ignoring invalid LOG_LEVEL on reload", "value", lvlStr, "error", err)
+				continue
+			}
+			Log.Infow("log level reloaded", "level", level.Level().String())
+		}
+	}()
+}
@@ -21,6 +21,11 @@ var (
 
 	serviceName string
 	envName     string
+
+	// level is the atomic level backing the global logger's core. Init resets
+	// its threshold on every call; Level()/LevelHandler() let operators change
+	// it afterwards without restarting the process.
+	level = zap.NewAtomicLevel()
 )
 
 const (
@@ -35,9 +40,24 @@ const (
 // env: environment tag (e.g., "prod" | "stage" | "dev").
 //
 // If level is empty, it will fallback to LOG_LEVEL env var, then to "info".
-func Init(level, service, env string) error {
+//
+// Output format (JSON vs. colored console) follows LOG_FORMAT, then defaults
+// to console when env=="dev" and stdout is a TTY, json otherwise; see
+// WithFormat to override it explicitly.
+//
+// Sampling is on by default (100 identical entries per second, then every
+// 100th), the same default etcd ships; pass WithSampling(0, 0, 0) to
+// InitWithOptions to log every entry uncapped.
+func Init(lvl, service, env string) error {
+	return InitWithOptions(lvl, service, env)
+}
+
+// InitWithOptions is Init plus optional sinks composed into a single
+// zapcore.Core, e.g. InitWithOptions(lvl, service, env, logger.WithLumberjack(...)).
+// With no options it behaves exactly like Init.
+func InitWithOptions(lvl, service, env string, opts ...Option) error {
 	// Resolve level: explicit arg > LOG_LEVEL > default
-	lvlStr := strings.TrimSpace(level)
+	lvlStr := strings.TrimSpace(lvl)
 	if lvlStr == "" {
 		lvlStr = strings.TrimSpace(os.Getenv("LOG_LEVEL"))
 	}
@@ -49,6 +69,7 @@ func Init(level, service, env string) error {
 	if err := zapLevel.UnmarshalText([]byte(strings.ToLower(lvlStr))); err != nil {
 		return fmt.Errorf("invalid log level %q: %w", lvlStr, err)
 	}
+	level.SetLevel(zapLevel)
 
 	// Resolve service/env with safe defaults
 	if strings.TrimSpace(service) == "" {
@@ -60,19 +81,36 @@ func Init(level, service, env string) error {
 
 	serviceName, envName = service, env
 
-	// Encoder config: JSON, RFC3339 time, stable keys.
-	encCfg := zap.NewProductionEncoderConfig()
-	encCfg.EncodeTime = func(t time.Time, pa zapcore.PrimitiveArrayEncoder) {
-		pa.AppendString(t.Format(time.RFC3339))
+	cfg := &buildConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	format := resolveFormat(cfg.format, envName)
+	encoder := buildEncoder(format)
+
+	stdoutLevel := zapcore.LevelEnabler(level)
+	if cfg.stderrErrors {
+		// True split: keep >=Error out of stdout once it has its own sink.
+		stdoutLevel = zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+			return level.Enabled(lvl) && lvl < zapcore.ErrorLevel
+		})
+	}
+	cores := append([]zapcore.Core{zapcore.NewCore(encoder, zapcore.AddSync(os.Stdout), stdoutLevel)}, cfg.cores...)
+	if cfg.stderrErrors {
+		errLevel := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+			return level.Enabled(lvl) && lvl >= zapcore.ErrorLevel
+		})
+		cores = append(cores, zapcore.NewCore(encoder, zapcore.AddSync(os.Stderr), errLevel))
 	}
-	encCfg.TimeKey = "ts"     // timestamp
-	encCfg.MessageKey = "msg" // message
-	encCfg.CallerKey = "caller"
-	encCfg.LevelKey = "level" // make sure it stays "level"
 
-	encoder := zapcore.NewJSONEncoder(encCfg)
-	ws := zapcore.AddSync(os.Stdout)
-	core := zapcore.NewCore(encoder, ws, zapLevel)
+	var core zapcore.Core
+	if len(cores) == 1 {
+		core = cores[0]
+	} else {
+		core = newLockedMultiCore(cores...)
+	}
+	core = wrapSampling(core, cfg.sampling)
 
 	// Add caller and stacktrace from error level.
 	z := zap.New(core, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
@@ -90,6 +128,21 @@ func Init(level, service, env string) error {
 	return nil
 }
 
+// jsonEncoder returns the JSON encoder used by the stdout core in json format
+// and by the WithLumberjack sink: RFC3339 time, stable keys.
+func jsonEncoder() zapcore.Encoder {
+	encCfg := zap.NewProductionEncoderConfig()
+	encCfg.EncodeTime = func(t time.Time, pa zapcore.PrimitiveArrayEncoder) {
+		pa.AppendString(t.Format(time.RFC3339))
+	}
+	encCfg.TimeKey = "ts"     // timestamp
+	encCfg.MessageKey = "msg" // message
+	encCfg.CallerKey = "caller"
+	encCfg.LevelKey = "level" // make sure it stays "level"
+
+	return zapcore.NewJSONEncoder(encCfg)
+}
+
 // ensureDefaultInit makes sure there is a usable global logger.
 // It is used when New() is called before Init().
 func ensureDefaultInit() {
@@ -0,0 +1,71 @@
+// File: logger/multicore.go
+// lockedMultiCore fans a single zapcore.Core call out to several underlying
+// cores (e.g. stdout + file rotation + a remote sink), guarded by an
+// RWMutex so concurrent With()/Write()/Sync() calls stay safe.
+package logger
+
+import (
+	"sync"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+)
+
+type lockedMultiCore struct {
+	mu    sync.RWMutex
+	cores []zapcore.Core
+}
+
+func newLockedMultiCore(cores ...zapcore.Core) *lockedMultiCore {
+	return &lockedMultiCore{cores: cores}
+}
+
+func (c *lockedMultiCore) Enabled(lvl zapcore.Level) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, core := range c.cores {
+		if core.Enabled(lvl) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *lockedMultiCore) With(fields []zapcore.Field) zapcore.Core {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cores := make([]zapcore.Core, len(c.cores))
+	for i, core := range c.cores {
+		cores[i] = core.With(fields)
+	}
+	return newLockedMultiCore(cores...)
+}
+
+func (c *lockedMultiCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, core := range c.cores {
+		ce = core.Check(ent, ce)
+	}
+	return ce
+}
+
+func (c *lockedMultiCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var err error
+	for _, core := range c.cores {
+		err = multierr.Append(err, core.Write(ent, fields))
+	}
+	return err
+}
+
+func (c *lockedMultiCore) Sync() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var err error
+	for _, core := range c.cores {
+		err = multierr.Append(err, core.Sync())
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// failingCore wraps an in-memory zapcore.Core but always fails Write/Sync,
+// so we can assert that lockedMultiCore fans out to every core and
+// aggregates their errors instead of stopping at the first one.
+type failingCore struct {
+	zapcore.Core
+	writeErr error
+	syncErr  error
+}
+
+func (c failingCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	_ = c.Core.Write(ent, fields)
+	return c.writeErr
+}
+
+func (c failingCore) Sync() error {
+	_ = c.Core.Sync()
+	return c.syncErr
+}
+
+func TestLockedMultiCoreFansOutWrites(t *testing.T) {
+	coreA, logsA := observer.New(zapcore.InfoLevel)
+	coreB, logsB := observer.New(zapcore.InfoLevel)
+
+	multi := newLockedMultiCore(coreA, coreB)
+
+	ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}
+	ce := multi.Check(ent, nil)
+	if ce == nil {
+		t.Fatalf("expected Check to return a non-nil CheckedEntry")
+	}
+	ce.Write()
+
+	if logsA.Len() != 1 || logsB.Len() != 1 {
+		t.Fatalf("expected both cores to receive the entry, got %d and %d", logsA.Len(), logsB.Len())
+	}
+}
+
+func TestLockedMultiCoreAggregatesWriteErrors(t *testing.T) {
+	coreA, _ := observer.New(zapcore.InfoLevel)
+	errA := errors.New("sink A down")
+	errB := errors.New("sink B down")
+
+	multi := newLockedMultiCore(
+		failingCore{Core: coreA, writeErr: errA},
+		failingCore{Core: coreA, writeErr: errB},
+	)
+
+	err := multi.Write(zapcore.Entry{Level: zapcore.InfoLevel}, nil)
+	if err == nil {
+		t.Fatalf("expected an aggregated error, got nil")
+	}
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("expected aggregated error to wrap both sink errors, got: %v", err)
+	}
+}
+
+func TestLockedMultiCoreAggregatesSyncErrors(t *testing.T) {
+	coreA, _ := observer.New(zapcore.InfoLevel)
+	errA := errors.New("sync A failed")
+	errB := errors.New("sync B failed")
+
+	multi := newLockedMultiCore(
+		failingCore{Core: coreA, syncErr: errA},
+		failingCore{Core: coreA, syncErr: errB},
+	)
+
+	err := multi.Sync()
+	if err == nil {
+		t.Fatalf("expected an aggregated error, got nil")
+	}
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("expected aggregated error to wrap both sync errors, got: %v", err)
+	}
+}
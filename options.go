@@ -0,0 +1,35 @@
+// File: logger/options.go
+// Option knobs for InitWithOptions: additional sinks composed into the
+// single zapcore.Core that backs the global logger.
+package logger
+
+import "go.uber.org/zap/zapcore"
+
+// buildConfig accumulates what InitWithOptions needs to assemble the final
+// core, on top of the stdout core Init always builds.
+type buildConfig struct {
+	cores        []zapcore.Core // additional cores, fanned out alongside stdout
+	stderrErrors bool
+	format       string // "json" | "console", see WithFormat
+	sampling     *samplingConfig
+}
+
+// Option configures InitWithOptions.
+type Option func(*buildConfig)
+
+// WithAdditionalCore fans logs out to an extra zapcore.Core as-is, e.g. one
+// backed by a Loki or Sentry client.
+func WithAdditionalCore(c zapcore.Core) Option {
+	return func(cfg *buildConfig) {
+		cfg.cores = append(cfg.cores, c)
+	}
+}
+
+// WithStderrErrors splits entries at Error level and above off to stderr:
+// the stdout core stops emitting them once this is set, so each entry is
+// still written exactly once.
+func WithStderrErrors() Option {
+	return func(cfg *buildConfig) {
+		cfg.stderrErrors = true
+	}
+}
@@ -0,0 +1,85 @@
+// File: logger/sampling.go
+// Sampling for high-volume log sites: the core built by Init is wrapped with
+// zapcore.NewSamplerWithOptions so repeated identical messages under load
+// don't drown the pipeline, the same pattern etcd uses in
+// DefaultZapLoggerConfig.
+package logger
+
+import (
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	defaultSamplingInitial    = 100
+	defaultSamplingThereafter = 100
+	defaultSamplingTick       = time.Second
+)
+
+type samplingConfig struct {
+	enabled    bool
+	initial    int
+	thereafter int
+	tick       time.Duration
+}
+
+// droppedBySampling counts entries the sampler decided not to log.
+var droppedBySampling uint64
+
+// WithSampling configures the sampler wrapping the core built by Init: the
+// first initial identical messages within each tick window are logged, then
+// only every thereafter-th one. Pass WithSampling(0, 0, 0) to disable
+// sampling entirely (every message is logged).
+func WithSampling(initial, thereafter int, tick time.Duration) Option {
+	return func(cfg *buildConfig) {
+		cfg.sampling = &samplingConfig{
+			enabled:    initial != 0 || thereafter != 0 || tick != 0,
+			initial:    initial,
+			thereafter: thereafter,
+			tick:       tick,
+		}
+	}
+}
+
+// wrapSampling applies sc to core. With no WithSampling option, it falls
+// back to the etcd-style default of 100 identical entries per second, then
+// every 100th.
+func wrapSampling(core zapcore.Core, sc *samplingConfig) zapcore.Core {
+	if sc == nil {
+		sc = &samplingConfig{
+			enabled:    true,
+			initial:    defaultSamplingInitial,
+			thereafter: defaultSamplingThereafter,
+			tick:       defaultSamplingTick,
+		}
+	}
+	if !sc.enabled {
+		return core
+	}
+	if sc.tick <= 0 {
+		// A non-positive tick is a degenerate window for time.NewTicker;
+		// fall back to the default rather than letting it panic downstream.
+		sc.tick = defaultSamplingTick
+	}
+	return zapcore.NewSamplerWithOptions(core, sc.tick, sc.initial, sc.thereafter,
+		zapcore.SamplerHook(func(_ zapcore.Entry, dec zapcore.SamplingDecision) {
+			if dec&zapcore.LogDropped != 0 {
+				atomic.AddUint64(&droppedBySampling, 1)
+			}
+		}),
+	)
+}
+
+// LogStats holds logger counters suitable for scrape endpoints.
+type LogStats struct {
+	// DroppedBySampling is the number of entries the sampler has skipped
+	// logging since process start.
+	DroppedBySampling uint64
+}
+
+// Stats returns a snapshot of the current logger counters.
+func Stats() LogStats {
+	return LogStats{DroppedBySampling: atomic.LoadUint64(&droppedBySampling)}
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWrapSamplingDropsAndCountsExcessEntries(t *testing.T) {
+	before := Stats().DroppedBySampling
+
+	obsCore, logs := observer.New(zapcore.InfoLevel)
+	sampled := wrapSampling(obsCore, &samplingConfig{
+		enabled:    true,
+		initial:    1,
+		thereafter: 1000, // effectively: only the very first entry per tick
+		tick:       time.Minute,
+	})
+
+	for i := 0; i < 5; i++ {
+		ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "high-volume line"}
+		if ce := sampled.Check(ent, nil); ce != nil {
+			ce.Write()
+		}
+	}
+
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("expected exactly 1 entry to pass the sampler, got %d", got)
+	}
+	if got := Stats().DroppedBySampling - before; got != 4 {
+		t.Fatalf("expected Stats().DroppedBySampling to increase by 4, got %d", got)
+	}
+}
+
+func TestWrapSamplingDisabledPassesEverythingThrough(t *testing.T) {
+	obsCore, logs := observer.New(zapcore.InfoLevel)
+	sampled := wrapSampling(obsCore, &samplingConfig{enabled: false})
+
+	for i := 0; i < 5; i++ {
+		ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "line"}
+		if ce := sampled.Check(ent, nil); ce != nil {
+			ce.Write()
+		}
+	}
+
+	if got := logs.Len(); got != 5 {
+		t.Fatalf("expected sampling disabled to let all 5 entries through, got %d", got)
+	}
+}
+
+func TestWrapSamplingDegenerateTickFallsBackToDefault(t *testing.T) {
+	obsCore, _ := observer.New(zapcore.InfoLevel)
+
+	// A zero tick must not panic (time.NewTicker requires tick > 0).
+	sampled := wrapSampling(obsCore, &samplingConfig{enabled: true, initial: 1, thereafter: 1, tick: 0})
+	if sampled == nil {
+		t.Fatalf("expected a non-nil core")
+	}
+}